@@ -0,0 +1,83 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+)
+
+// Deck is one or more standard 52-card decks that cards can be shuffled
+// and drawn from. The doc comment on BestHand already notes that multiple
+// decks are allowed; Deck makes that first-class so callers can simulate
+// games instead of only scoring hand strings.
+type Deck struct {
+	cards []card // remaining, undealt cards, in draw order
+	full  []card // the full deck(s), kept aside so Reset can restore them
+}
+
+// NewDeck builds an unshuffled Deck made up of numDecks standard 52-card
+// decks. It panics if numDecks is not positive.
+func NewDeck(numDecks int) *Deck {
+	if numDecks <= 0 {
+		panic("poker: NewDeck requires a positive number of decks")
+	}
+	full := make([]card, 0, 52*numDecks)
+	for i := 0; i < numDecks; i++ {
+		full = append(full, newStandardDeck()...)
+	}
+	return newDeckFromCards(full)
+}
+
+// newDeckFromCards builds a Deck from an already-assembled set of cards,
+// letting internal callers deal from a short deck or other custom deck.
+func newDeckFromCards(cards []card) *Deck {
+	d := &Deck{full: append([]card{}, cards...)}
+	d.Reset()
+	return d
+}
+
+// Shuffle randomises the order of the remaining cards using rnd.
+func (d *Deck) Shuffle(rnd *rand.Rand) {
+	rnd.Shuffle(len(d.cards), func(i, j int) { d.cards[i], d.cards[j] = d.cards[j], d.cards[i] })
+}
+
+// Draw removes and returns the next n cards from the deck.
+func (d *Deck) Draw(n int) ([]card, error) {
+	if n < 0 || n > len(d.cards) {
+		return nil, fmt.Errorf("not enough cards left in deck: have %d, want %d", len(d.cards), n)
+	}
+	drawn := append([]card{}, d.cards[:n]...)
+	d.cards = d.cards[n:]
+	return drawn, nil
+}
+
+// Reset restores every card dealt so far, in its original unshuffled order.
+func (d *Deck) Reset() {
+	d.cards = append([]card{}, d.full...)
+}
+
+// String returns the card in the same "A♤" format validateHand parses.
+func (c card) String() string {
+	return rankString(c.rank) + c.suit
+}
+
+func rankString(rank int) string {
+	switch rank {
+	case 14:
+		return "A"
+	case 13:
+		return "K"
+	case 12:
+		return "Q"
+	case 11:
+		return "J"
+	default:
+		return strconv.Itoa(rank)
+	}
+}
+
+// ParseCard parses a single card token such as "A♤" into a card, the
+// inverse of card.String.
+func ParseCard(token string) (card, error) {
+	return parseCard(token)
+}
@@ -0,0 +1,106 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestNewDeckSize(t *testing.T) {
+	d := NewDeck(1)
+	if got := len(d.cards); got != 52 {
+		t.Errorf("len(cards) = %d, want 52", got)
+	}
+
+	d = NewDeck(3)
+	if got := len(d.cards); got != 156 {
+		t.Errorf("len(cards) = %d, want 156 for 3 decks", got)
+	}
+}
+
+func TestNewDeckPanicsOnNonPositiveCount(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewDeck(0): want panic, got none")
+		}
+	}()
+	NewDeck(0)
+}
+
+func TestDeckDraw(t *testing.T) {
+	d := NewDeck(1)
+	drawn, err := d.Draw(5)
+	if err != nil {
+		t.Fatalf("Draw: %v", err)
+	}
+	if len(drawn) != 5 {
+		t.Errorf("len(drawn) = %d, want 5", len(drawn))
+	}
+	if got := len(d.cards); got != 47 {
+		t.Errorf("len(cards) after draw = %d, want 47", got)
+	}
+
+	if _, err := d.Draw(48); err == nil {
+		t.Error("Draw(48) with 47 left: want error, got nil")
+	}
+}
+
+func TestDeckReset(t *testing.T) {
+	d := NewDeck(1)
+	original := append([]card{}, d.cards...)
+	d.Shuffle(rand.New(rand.NewSource(1)))
+	if _, err := d.Draw(10); err != nil {
+		t.Fatalf("Draw: %v", err)
+	}
+
+	d.Reset()
+	if len(d.cards) != 52 {
+		t.Fatalf("len(cards) after Reset = %d, want 52", len(d.cards))
+	}
+	for i, c := range d.cards {
+		if c != original[i] {
+			t.Fatalf("card %d = %v after Reset, want %v (original unshuffled order)", i, c, original[i])
+		}
+	}
+}
+
+func TestDeckShuffleChangesOrder(t *testing.T) {
+	d := NewDeck(1)
+	original := append([]card{}, d.cards...)
+	d.Shuffle(rand.New(rand.NewSource(1)))
+	if len(d.cards) != len(original) {
+		t.Fatalf("len(cards) after Shuffle = %d, want %d", len(d.cards), len(original))
+	}
+	same := true
+	for i, c := range d.cards {
+		if c != original[i] {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Error("Shuffle: deck order is unchanged, want a different order")
+	}
+
+	seen := make(map[card]bool, len(d.cards))
+	for _, c := range d.cards {
+		seen[c] = true
+	}
+	for _, c := range original {
+		if !seen[c] {
+			t.Fatalf("card %v missing after Shuffle", c)
+		}
+	}
+}
+
+func TestCardStringParseCardRoundTrip(t *testing.T) {
+	for _, c := range newStandardDeck() {
+		token := c.String()
+		got, err := ParseCard(token)
+		if err != nil {
+			t.Fatalf("ParseCard(%q): %v", token, err)
+		}
+		if got != c {
+			t.Errorf("ParseCard(%q) = %v, want %v", token, got, c)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// maxExhaustiveBoards is the largest number of remaining board
+// combinations Equity will enumerate exhaustively before falling back to
+// Monte Carlo sampling.
+const maxExhaustiveBoards = 50000
+
+// EquityOptions configures Equity.
+type EquityOptions struct {
+	// Variant determines how each hand's hole cards combine with the
+	// board. It defaults to HoldemVariant{}.
+	Variant Variant
+	// BoardSize is the number of community cards on a complete board. It
+	// defaults to 5.
+	BoardSize int
+	// Iterations is the number of Monte Carlo boards to sample when
+	// exhaustive enumeration would be too expensive. It defaults to
+	// 100000.
+	Iterations int
+	// Rand supplies randomness for Monte Carlo sampling. It is only
+	// required when exhaustive enumeration isn't used.
+	Rand *rand.Rand
+}
+
+// EquityResult reports one hand's equity against the rest of the field,
+// as a fraction of the boards considered.
+type EquityResult struct {
+	Win  float64
+	Tie  float64
+	Lose float64
+	// CategoryFrequency reports, for each category, how often the hand
+	// made that category.
+	CategoryFrequency map[category]float64
+}
+
+// Equity computes each hand's win/tie/lose probability against the
+// remaining deck, completing board from its current state (pre-flop
+// through river) for range analysis. It enumerates every possible board
+// exhaustively when that's cheap, and falls back to Monte Carlo sampling
+// otherwise.
+func Equity(hands [][]card, board []card, opts EquityOptions) ([]EquityResult, error) {
+	if len(hands) == 0 {
+		return nil, errors.New("equity requires at least one hand")
+	}
+	v := opts.Variant
+	if v == nil {
+		v = HoldemVariant{}
+	}
+	boardSize := opts.BoardSize
+	if boardSize == 0 {
+		boardSize = 5
+	}
+	missing := boardSize - len(board)
+	if missing < 0 {
+		return nil, fmt.Errorf("board already has %d cards, more than boardSize %d", len(board), boardSize)
+	}
+
+	used := make(map[card]bool)
+	for _, h := range hands {
+		for _, c := range h {
+			used[c] = true
+		}
+	}
+	for _, c := range board {
+		used[c] = true
+	}
+
+	baseDeck := newStandardDeck()
+	if _, ok := v.(ShortDeckVariant); ok {
+		baseDeck = newShortDeck()
+	}
+	remaining := make([]card, 0, len(baseDeck))
+	for _, c := range baseDeck {
+		if !used[c] {
+			remaining = append(remaining, c)
+		}
+	}
+	if missing > len(remaining) {
+		return nil, fmt.Errorf("not enough cards left in deck to complete the board: need %d, have %d", missing, len(remaining))
+	}
+
+	// Validate every hand's shape against the variant up front, against a
+	// board padded out to full size, so a malformed hand is reported here
+	// rather than panicking partway through exhaustive enumeration or
+	// Monte Carlo sampling.
+	validationBoard := append(append([]card{}, board...), remaining[:missing]...)
+	for _, h := range hands {
+		if _, err := v.Eval(h, validationBoard); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]EquityResult, len(hands))
+	for i := range results {
+		results[i].CategoryFrequency = make(map[category]float64)
+	}
+
+	if combinationCount(len(remaining), missing) <= maxExhaustiveBoards {
+		boards := 0
+		combinations(len(remaining), missing, func(idx []int) {
+			extra := make([]card, missing)
+			for i, ci := range idx {
+				extra[i] = remaining[ci]
+			}
+			tallyBoard(v, hands, append(append([]card{}, board...), extra...), results)
+			boards++
+		})
+		finalizeEquity(results, boards)
+		return results, nil
+	}
+
+	if opts.Rand == nil {
+		return nil, errors.New("equity: Rand is required for Monte Carlo sampling")
+	}
+	iterations := opts.Iterations
+	if iterations == 0 {
+		iterations = 100000
+	}
+	pool := append([]card{}, remaining...)
+	for i := 0; i < iterations; i++ {
+		opts.Rand.Shuffle(len(pool), func(a, b int) { pool[a], pool[b] = pool[b], pool[a] })
+		full := append(append([]card{}, board...), pool[:missing]...)
+		tallyBoard(v, hands, full, results)
+	}
+	finalizeEquity(results, iterations)
+	return results, nil
+}
+
+// tallyBoard evaluates every hand against one completed board and adds the
+// outcome into results.
+func tallyBoard(v Variant, hands [][]card, fullBoard []card, results []EquityResult) {
+	handResults := make([]hand, len(hands))
+	for i, h := range hands {
+		hr, err := v.Eval(h, fullBoard)
+		if err != nil {
+			// Equity validates every hand's shape before sampling begins,
+			// so a fully-formed board should never fail here.
+			panic(err)
+		}
+		handResults[i] = hr
+		results[i].CategoryFrequency[hr.cat]++
+	}
+	best := handResults[0]
+	for _, hr := range handResults[1:] {
+		if v.Beats(hr, best) {
+			best = hr
+		}
+	}
+	isWinner := make([]bool, len(hands))
+	winners := 0
+	for i, hr := range handResults {
+		if !v.Beats(best, hr) && !v.Beats(hr, best) {
+			isWinner[i] = true
+			winners++
+		}
+	}
+	for i := range hands {
+		switch {
+		case !isWinner[i]:
+			results[i].Lose++
+		case winners == 1:
+			results[i].Win++
+		default:
+			results[i].Tie++
+		}
+	}
+}
+
+// finalizeEquity turns the accumulated counts in results into fractions of
+// the n boards considered.
+func finalizeEquity(results []EquityResult, n int) {
+	total := float64(n)
+	for i := range results {
+		results[i].Win /= total
+		results[i].Tie /= total
+		results[i].Lose /= total
+		for cat, count := range results[i].CategoryFrequency {
+			results[i].CategoryFrequency[cat] = count / total
+		}
+	}
+}
+
+// combinationCount returns n choose k without overflowing for the deck
+// sizes this package works with.
+func combinationCount(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	if k > n-k {
+		k = n - k
+	}
+	result := 1
+	for i := 0; i < k; i++ {
+		result = result * (n - i) / (i + 1)
+	}
+	return result
+}
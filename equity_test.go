@@ -0,0 +1,87 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEquityExhaustiveCompleteBoard(t *testing.T) {
+	aceAce := []card{mustParseCard(t, "A♤"), mustParseCard(t, "A♡")}
+	kingKing := []card{mustParseCard(t, "K♧"), mustParseCard(t, "K♢")}
+	board := []card{
+		mustParseCard(t, "2♢"), mustParseCard(t, "7♧"), mustParseCard(t, "9♡"),
+		mustParseCard(t, "J♢"), mustParseCard(t, "3♤"),
+	}
+
+	results, err := Equity([][]card{aceAce, kingKing}, board, EquityOptions{})
+	if err != nil {
+		t.Fatalf("Equity: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(results))
+	}
+
+	// The board is already complete, so there's exactly one board to
+	// consider and the outcome is deterministic: pair of aces beats pair
+	// of kings outright.
+	if results[0].Win != 1 || results[0].Lose != 0 || results[0].Tie != 0 {
+		t.Errorf("aceAce = %+v, want Win=1", results[0])
+	}
+	if results[1].Win != 0 || results[1].Lose != 1 || results[1].Tie != 0 {
+		t.Errorf("kingKing = %+v, want Lose=1", results[1])
+	}
+	if results[0].CategoryFrequency[onePair] != 1 {
+		t.Errorf("aceAce CategoryFrequency[onePair] = %v, want 1", results[0].CategoryFrequency[onePair])
+	}
+}
+
+func TestEquityMonteCarloConvergesToKnownEquity(t *testing.T) {
+	aceAce := []card{mustParseCard(t, "A♤"), mustParseCard(t, "A♡")}
+	kingKing := []card{mustParseCard(t, "K♧"), mustParseCard(t, "K♢")}
+
+	// No board at all: C(48, 5) remaining boards is far above
+	// maxExhaustiveBoards, so this forces the Monte Carlo branch.
+	results, err := Equity([][]card{aceAce, kingKing}, nil, EquityOptions{
+		Iterations: 20000,
+		Rand:       rand.New(rand.NewSource(1)),
+	})
+	if err != nil {
+		t.Fatalf("Equity: %v", err)
+	}
+
+	// AA vs KK preflop is a well-known ~82/18 equity split.
+	const wantWin, tolerance = 0.82, 0.03
+	if got := results[0].Win; math.Abs(got-wantWin) > tolerance {
+		t.Errorf("aceAce.Win = %v, want within %v of %v", got, tolerance, wantWin)
+	}
+	if got := results[0].Win + results[0].Lose + results[0].Tie; math.Abs(got-1) > 1e-9 {
+		t.Errorf("aceAce Win+Lose+Tie = %v, want 1", got)
+	}
+}
+
+func TestEquityRejectsMalformedHandUpFront(t *testing.T) {
+	// Omaha requires exactly 4 hole cards; this hand only has 2, and
+	// should be rejected before any board sampling begins rather than
+	// panicking partway through.
+	tooFewHoleCards := []card{mustParseCard(t, "A♤"), mustParseCard(t, "A♡")}
+	board := []card{mustParseCard(t, "2♢"), mustParseCard(t, "7♧")}
+
+	_, err := Equity([][]card{tooFewHoleCards}, board, EquityOptions{Variant: OmahaVariant{}})
+	if err == nil {
+		t.Fatal("Equity: want error for a hand with too few hole cards, got nil")
+	}
+}
+
+func TestEquityRejectsOversizedBoard(t *testing.T) {
+	board := []card{
+		mustParseCard(t, "2♢"), mustParseCard(t, "7♧"), mustParseCard(t, "9♡"),
+		mustParseCard(t, "J♢"), mustParseCard(t, "3♤"), mustParseCard(t, "4♧"),
+	}
+	hands := [][]card{{mustParseCard(t, "A♤"), mustParseCard(t, "A♡")}}
+
+	_, err := Equity(hands, board, EquityOptions{})
+	if err == nil {
+		t.Fatal("Equity: want error when board already exceeds BoardSize, got nil")
+	}
+}
@@ -0,0 +1,452 @@
+package poker
+
+import (
+	"math"
+	"sort"
+)
+
+// primesByRank maps each card rank (2-14) to a unique prime. Since primes
+// are coprime, the product of five cards' primes identifies their
+// multiset of ranks uniquely, regardless of suit or card order.
+var primesByRank = map[int]int{
+	2: 2, 3: 3, 4: 5, 5: 7, 6: 11, 7: 13, 8: 17, 9: 19, 10: 23, 11: 29, 12: 31, 13: 37, 14: 41,
+}
+
+// Rank() values are partitioned into these categories, lowest first, built
+// by buildRankTables. A straight flush is always 1-10; a plain high card
+// hand is always maxOnePair+1 through maxHighCard (7462).
+const (
+	maxStraightFlush = 10
+	maxFourKind      = maxStraightFlush + 156
+	maxFullHouse     = maxFourKind + 156
+	maxFlush         = maxFullHouse + 1277
+	maxStraight      = maxFlush + 10
+	maxThreeKind     = maxStraight + 858
+	maxTwoPair       = maxThreeKind + 858
+	maxOnePair       = maxTwoPair + 2860
+	maxHighCard      = maxOnePair + 1277 // == 7462
+)
+
+// flushes maps a 13-bit rank bitmask (bit 0 for rank 2, bit 12 for rank
+// ace) to its Rank() value for a flush hand, including straight flushes.
+// unique5 maps a rank bitmask to its Rank() value for a non-flush
+// straight. products maps the product of five card primes to the Rank()
+// value of every other non-flush hand: four of a kind, full house, three
+// of a kind, two pair, one pair, and plain high card.
+var (
+	flushes  = map[int]int32{}
+	unique5  = map[int]int32{}
+	products = map[int]int32{}
+)
+
+// shortDeckFlushes, shortDeckUnique5 and shortDeckProducts are the Short
+// Deck (6+) equivalents of flushes/unique5/products, built from a 9-rank
+// (6-14) deck by the same table-building steps, just in a different order
+// since flush outranks full house once the low cards are removed.
+// shortDeckCategoryBounds records, in the order the tables were built
+// (strongest category first), the highest Rank value still in each
+// category, so RankShortDeckCategory can look a value up without assuming
+// the standard deck's category ordering.
+var (
+	shortDeckFlushes        = map[int]int32{}
+	shortDeckUnique5        = map[int]int32{}
+	shortDeckProducts       = map[int]int32{}
+	shortDeckCategoryBounds []categoryBound
+)
+
+type categoryBound struct {
+	cat category
+	max int32
+}
+
+func init() {
+	buildRankTables()
+	buildShortDeckRankTables()
+}
+
+// Rank scores a 5-, 6-, or 7-card hand to a single integer where lower
+// means stronger, enabling O(1) comparisons for community-card games like
+// Hold'em and Omaha. For 6 or 7 cards it evaluates every 5-card subset and
+// keeps the best. It panics if cards is not of length 5, 6, or 7.
+func Rank(cards ...card) int32 {
+	return bestOfFive(cards, func(five []card) int32 { return rank5(five, flushes, unique5, products) })
+}
+
+// RankShortDeck is Rank's Short Deck (6+) equivalent: A-6-7-8-9 is the
+// lowest straight in place of A-2-3-4-5, and flush outranks full house
+// since removing the low cards makes flushes harder to make. It panics if
+// cards is not of length 5, 6, or 7.
+func RankShortDeck(cards ...card) int32 {
+	return bestOfFive(cards, func(five []card) int32 {
+		return rank5(five, shortDeckFlushes, shortDeckUnique5, shortDeckProducts)
+	})
+}
+
+// bestOfFive scores a 5-, 6-, or 7-card hand by scoring every 5-card
+// subset with score and keeping the lowest (strongest) value.
+func bestOfFive(cards []card, score func([]card) int32) int32 {
+	switch len(cards) {
+	case 5:
+		return score(cards)
+	case 6, 7:
+		best := int32(math.MaxInt32)
+		combinations(len(cards), 5, func(idx []int) {
+			five := make([]card, 5)
+			for i, ci := range idx {
+				five[i] = cards[ci]
+			}
+			if r := score(five); r < best {
+				best = r
+			}
+		})
+		return best
+	default:
+		panic("poker: Rank requires 5, 6, or 7 cards")
+	}
+}
+
+// RankCategory maps a Rank() value back to the category enum, so existing
+// code can keep using category to drive display.
+func RankCategory(r int32) category {
+	switch {
+	case r <= maxStraightFlush:
+		return straightFlush
+	case r <= maxFourKind:
+		return fourKind
+	case r <= maxFullHouse:
+		return fullHouse
+	case r <= maxFlush:
+		return flush
+	case r <= maxStraight:
+		return straight
+	case r <= maxThreeKind:
+		return threeKind
+	case r <= maxTwoPair:
+		return twoPair
+	case r <= maxOnePair:
+		return onePair
+	default:
+		return highCard
+	}
+}
+
+// RankShortDeckCategory maps a RankShortDeck() value back to the category
+// enum. Unlike RankCategory, the boundaries aren't fixed constants: they
+// depend on the order shortDeckCategoryBounds was built in, which puts
+// flush ahead of fullHouse.
+func RankShortDeckCategory(r int32) category {
+	for _, b := range shortDeckCategoryBounds {
+		if r <= b.max {
+			return b.cat
+		}
+	}
+	return highCard
+}
+
+// rank5 scores an exact 5-card hand using the given precomputed tables.
+func rank5(cards []card, flushes, unique5, products map[int]int32) int32 {
+	rankBits := 0
+	primeProduct := 1
+	flushHand := true
+	for i, c := range cards {
+		rankBits |= 1 << (c.rank - 2)
+		primeProduct *= primesByRank[c.rank]
+		if i > 0 && c.suit != cards[0].suit {
+			flushHand = false
+		}
+	}
+	if flushHand {
+		if r, ok := flushes[rankBits]; ok {
+			return r
+		}
+	}
+	if r, ok := unique5[rankBits]; ok {
+		return r
+	}
+	return products[primeProduct]
+}
+
+// buildRankTables fills flushes, unique5, and products by walking every
+// hand category from strongest to weakest and handing out sequential
+// Rank() values, following the classic two-table (Cactus Kev) approach.
+func buildRankTables() {
+	ranksDesc := allRanksDesc()
+	patterns := straightPatterns()
+	straightMasks := make(map[int]bool, len(patterns))
+	allFive := rankCombosFrom(ranksDesc, 5)
+
+	rankValue := int32(1)
+	rankValue = buildStraightFlushes(rankValue, patterns, straightMasks, flushes)
+	rankValue = buildFourKind(rankValue, ranksDesc, products)
+	rankValue = buildFullHouse(rankValue, ranksDesc, products)
+	rankValue = buildFlushes(rankValue, allFive, straightMasks, flushes)
+	rankValue = buildStraights(rankValue, patterns, unique5)
+	rankValue = buildThreeKind(rankValue, ranksDesc, products)
+	rankValue = buildTwoPair(rankValue, ranksDesc, products)
+	rankValue = buildOnePair(rankValue, ranksDesc, products)
+	buildHighCard(rankValue, allFive, straightMasks, products)
+}
+
+// buildShortDeckRankTables fills shortDeckFlushes, shortDeckUnique5 and
+// shortDeckProducts the same way buildRankTables does, but over the 9
+// ranks (6-14) Short Deck (6+) is played with, its own A-6-7-8-9 wheel,
+// and with flush built ahead of full house so a flush always scores lower
+// (stronger) than a full house.
+func buildShortDeckRankTables() {
+	ranksDesc := shortDeckRanksDesc()
+	patterns := shortDeckStraightPatterns()
+	straightMasks := make(map[int]bool, len(patterns))
+	allFive := rankCombosFrom(ranksDesc, 5)
+
+	bound := func(cat category, rankValue int32) {
+		shortDeckCategoryBounds = append(shortDeckCategoryBounds, categoryBound{cat, rankValue - 1})
+	}
+
+	rankValue := int32(1)
+	rankValue = buildStraightFlushes(rankValue, patterns, straightMasks, shortDeckFlushes)
+	bound(straightFlush, rankValue)
+	rankValue = buildFourKind(rankValue, ranksDesc, shortDeckProducts)
+	bound(fourKind, rankValue)
+	rankValue = buildFlushes(rankValue, allFive, straightMasks, shortDeckFlushes)
+	bound(flush, rankValue)
+	rankValue = buildFullHouse(rankValue, ranksDesc, shortDeckProducts)
+	bound(fullHouse, rankValue)
+	rankValue = buildStraights(rankValue, patterns, shortDeckUnique5)
+	bound(straight, rankValue)
+	rankValue = buildThreeKind(rankValue, ranksDesc, shortDeckProducts)
+	bound(threeKind, rankValue)
+	rankValue = buildTwoPair(rankValue, ranksDesc, shortDeckProducts)
+	bound(twoPair, rankValue)
+	rankValue = buildOnePair(rankValue, ranksDesc, shortDeckProducts)
+	bound(onePair, rankValue)
+	rankValue = buildHighCard(rankValue, allFive, straightMasks, shortDeckProducts)
+	bound(highCard, rankValue)
+}
+
+// buildStraightFlushes assigns rankValue upward to every straight flush,
+// best (broadway) to worst (wheel), and records which rank masks are
+// straights so later steps can exclude them.
+func buildStraightFlushes(rankValue int32, patterns [][]int, straightMasks map[int]bool, flushes map[int]int32) int32 {
+	for _, p := range patterns {
+		mask := rankMask(p)
+		straightMasks[mask] = true
+		flushes[mask] = rankValue
+		rankValue++
+	}
+	return rankValue
+}
+
+// buildFourKind assigns rankValue upward to every four of a kind: quad
+// rank desc, then kicker rank desc.
+func buildFourKind(rankValue int32, ranksDesc []int, products map[int]int32) int32 {
+	for _, quad := range ranksDesc {
+		for _, kicker := range ranksExcept(ranksDesc, quad) {
+			products[primePow(quad, 4)*primesByRank[kicker]] = rankValue
+			rankValue++
+		}
+	}
+	return rankValue
+}
+
+// buildFullHouse assigns rankValue upward to every full house: trip rank
+// desc, then pair rank desc.
+func buildFullHouse(rankValue int32, ranksDesc []int, products map[int]int32) int32 {
+	for _, trip := range ranksDesc {
+		for _, pair := range ranksExcept(ranksDesc, trip) {
+			products[primePow(trip, 3)*primePow(pair, 2)] = rankValue
+			rankValue++
+		}
+	}
+	return rankValue
+}
+
+// buildFlushes assigns rankValue upward to every flush, best to worst,
+// excluding the straight flushes already assigned.
+func buildFlushes(rankValue int32, allFive [][]int, straightMasks map[int]bool, flushes map[int]int32) int32 {
+	for _, combo := range allFive {
+		mask := rankMask(combo)
+		if straightMasks[mask] {
+			continue
+		}
+		flushes[mask] = rankValue
+		rankValue++
+	}
+	return rankValue
+}
+
+// buildStraights assigns rankValue upward to every non-flush straight,
+// best to worst.
+func buildStraights(rankValue int32, patterns [][]int, unique5 map[int]int32) int32 {
+	for _, p := range patterns {
+		unique5[rankMask(p)] = rankValue
+		rankValue++
+	}
+	return rankValue
+}
+
+// buildThreeKind assigns rankValue upward to every three of a kind: trip
+// rank desc, then the two kickers, best to worst.
+func buildThreeKind(rankValue int32, ranksDesc []int, products map[int]int32) int32 {
+	for _, trip := range ranksDesc {
+		for _, kickers := range rankCombosFrom(ranksExcept(ranksDesc, trip), 2) {
+			products[primePow(trip, 3)*primesByRank[kickers[0]]*primesByRank[kickers[1]]] = rankValue
+			rankValue++
+		}
+	}
+	return rankValue
+}
+
+// buildTwoPair assigns rankValue upward to every two pair: the two pair
+// ranks (higher pair first), then the kicker.
+func buildTwoPair(rankValue int32, ranksDesc []int, products map[int]int32) int32 {
+	for _, pairRanks := range rankCombosFrom(ranksDesc, 2) {
+		for _, kicker := range ranksExcept(ranksDesc, pairRanks...) {
+			products[primePow(pairRanks[0], 2)*primePow(pairRanks[1], 2)*primesByRank[kicker]] = rankValue
+			rankValue++
+		}
+	}
+	return rankValue
+}
+
+// buildOnePair assigns rankValue upward to every one pair: pair rank
+// desc, then the three kickers, best to worst.
+func buildOnePair(rankValue int32, ranksDesc []int, products map[int]int32) int32 {
+	for _, pair := range ranksDesc {
+		for _, kickers := range rankCombosFrom(ranksExcept(ranksDesc, pair), 3) {
+			product := primePow(pair, 2)
+			for _, k := range kickers {
+				product *= primesByRank[k]
+			}
+			products[product] = rankValue
+			rankValue++
+		}
+	}
+	return rankValue
+}
+
+// buildHighCard assigns rankValue upward to every plain high card hand,
+// best to worst, excluding the straights already assigned.
+func buildHighCard(rankValue int32, allFive [][]int, straightMasks map[int]bool, products map[int]int32) int32 {
+	for _, combo := range allFive {
+		mask := rankMask(combo)
+		if straightMasks[mask] {
+			continue
+		}
+		product := 1
+		for _, r := range combo {
+			product *= primesByRank[r]
+		}
+		products[product] = rankValue
+		rankValue++
+	}
+	return rankValue
+}
+
+// straightPatterns returns the five-rank set of every straight, from the
+// ace-high straight (broadway) down to the wheel (A-2-3-4-5), in order
+// since a higher straight is the stronger hand.
+func straightPatterns() [][]int {
+	var out [][]int
+	for high := 14; high >= 6; high-- {
+		ranks := make([]int, 5)
+		for i := 0; i < 5; i++ {
+			ranks[i] = high - i
+		}
+		out = append(out, ranks)
+	}
+	return append(out, []int{14, 5, 4, 3, 2})
+}
+
+// shortDeckRanksDesc returns every card rank Short Deck (6+) is played
+// with, from ace (14) down to 6.
+func shortDeckRanksDesc() []int {
+	ranks := make([]int, 0, 9)
+	for r := 14; r >= 6; r-- {
+		ranks = append(ranks, r)
+	}
+	return ranks
+}
+
+// shortDeckStraightPatterns is straightPatterns' Short Deck (6+)
+// equivalent: the ace-high straight down to 6-7-8-9-10, then the
+// A-6-7-8-9 wheel in place of A-2-3-4-5.
+func shortDeckStraightPatterns() [][]int {
+	var out [][]int
+	for high := 14; high >= 10; high-- {
+		ranks := make([]int, 5)
+		for i := 0; i < 5; i++ {
+			ranks[i] = high - i
+		}
+		out = append(out, ranks)
+	}
+	return append(out, []int{14, 9, 8, 7, 6})
+}
+
+// allRanksDesc returns every card rank from ace (14) down to 2.
+func allRanksDesc() []int {
+	ranks := make([]int, 0, 13)
+	for r := 14; r >= 2; r-- {
+		ranks = append(ranks, r)
+	}
+	return ranks
+}
+
+// ranksExcept returns ranks with the given ranks removed.
+func ranksExcept(ranks []int, exclude ...int) []int {
+	skip := make(map[int]bool, len(exclude))
+	for _, r := range exclude {
+		skip[r] = true
+	}
+	out := make([]int, 0, len(ranks)-len(exclude))
+	for _, r := range ranks {
+		if !skip[r] {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// rankCombosFrom returns every k-rank combination from ranks, ordered from
+// the strongest (compared highest-rank-first) to the weakest.
+func rankCombosFrom(ranks []int, k int) [][]int {
+	var combos [][]int
+	var rec func(start int, chosen []int)
+	rec = func(start int, chosen []int) {
+		if len(chosen) == k {
+			combos = append(combos, append([]int{}, chosen...))
+			return
+		}
+		for i := start; i < len(ranks); i++ {
+			rec(i+1, append(chosen, ranks[i]))
+		}
+	}
+	rec(0, nil)
+	sort.Slice(combos, func(i, j int) bool {
+		for x := range combos[i] {
+			if combos[i][x] != combos[j][x] {
+				return combos[i][x] > combos[j][x]
+			}
+		}
+		return false
+	})
+	return combos
+}
+
+// rankMask returns the 13-bit rank bitmask for the given ranks.
+func rankMask(ranks []int) int {
+	mask := 0
+	for _, r := range ranks {
+		mask |= 1 << (r - 2)
+	}
+	return mask
+}
+
+// primePow returns primesByRank[rank] raised to the n-th power.
+func primePow(rank, n int) int {
+	p := primesByRank[rank]
+	product := 1
+	for i := 0; i < n; i++ {
+		product *= p
+	}
+	return product
+}
@@ -0,0 +1,74 @@
+package poker
+
+import "testing"
+
+// knownCategoryCounts is the standard count of 5-card hands (from a single
+// 52-card deck) in each category, used to check the precomputed tables
+// against the well-known combinatorics rather than just a handful of
+// examples.
+var knownCategoryCounts = map[category]int{
+	straightFlush: 40,
+	fourKind:      624,
+	fullHouse:     3744,
+	flush:         5108,
+	straight:      10200,
+	threeKind:     54912,
+	twoPair:       123552,
+	onePair:       1098240,
+	highCard:      1302540,
+}
+
+func TestRankTableMatchesKnownHandCounts(t *testing.T) {
+	deck := newStandardDeck()
+	counts := make(map[category]int)
+	combinations(len(deck), 5, func(idx []int) {
+		hand := make([]card, 5)
+		for i, ci := range idx {
+			hand[i] = deck[ci]
+		}
+		counts[RankCategory(Rank(hand...))]++
+	})
+
+	var total int
+	for cat, want := range knownCategoryCounts {
+		if got := counts[cat]; got != want {
+			t.Errorf("category %v: got %d hands, want %d", cat, got, want)
+		}
+		total += counts[cat]
+	}
+	if total != 2598960 {
+		t.Errorf("total hands = %d, want 2598960 (52 choose 5)", total)
+	}
+}
+
+func TestRankKnownHandOrdering(t *testing.T) {
+	must := func(hand []string) []card {
+		cards := make([]card, len(hand))
+		for i, tok := range hand {
+			c, err := parseCard(tok)
+			if err != nil {
+				t.Fatalf("parseCard(%q): %v", tok, err)
+			}
+			cards[i] = c
+		}
+		return cards
+	}
+
+	royalFlush := Rank(must([]string{"A♤", "K♤", "Q♤", "J♤", "10♤"})...)
+	quadAces := Rank(must([]string{"A♤", "A♧", "A♡", "A♢", "K♤"})...)
+	wheelStraight := Rank(must([]string{"A♤", "2♧", "3♡", "4♢", "5♤"})...)
+	highCardHand := Rank(must([]string{"A♤", "K♧", "Q♡", "J♢", "9♤"})...)
+
+	if !(royalFlush < quadAces) {
+		t.Errorf("royal flush (%d) should outrank quad aces (%d)", royalFlush, quadAces)
+	}
+	if !(quadAces < wheelStraight) {
+		t.Errorf("quad aces (%d) should outrank a wheel straight (%d)", quadAces, wheelStraight)
+	}
+	if !(wheelStraight < highCardHand) {
+		t.Errorf("wheel straight (%d) should outrank a high card hand (%d)", wheelStraight, highCardHand)
+	}
+	if got := RankCategory(wheelStraight); got != straight {
+		t.Errorf("wheel straight categorised as %v, want straight", got)
+	}
+}
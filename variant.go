@@ -0,0 +1,326 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// Variant describes a poker game variant: how hole cards are dealt, how a
+// player's hole cards combine with the community cards to produce a hand,
+// and how two such hands are compared. HoldemVariant, OmahaVariant and
+// ShortDeckVariant are the concrete variants provided by this package;
+// BestHand uses the unexported studVariant, which has no community cards.
+type Variant interface {
+	// Deal shuffles a fresh deck and distributes hole cards to the given
+	// number of players, returning each player's hole cards plus the
+	// community cards available to be dealt onto the board.
+	Deal(players int, rnd *rand.Rand) (holes [][]card, community []card, err error)
+
+	// Eval scores a player's hole cards together with the community cards
+	// dealt so far, returning the best qualifying hand.
+	Eval(holeCards, community []card) (hand, error)
+
+	// BestFive selects the five cards that make up Eval's hand, honouring
+	// any variant-specific usage rule, such as Omaha's exactly-two-hole
+	// rule.
+	BestFive(holeCards, community []card) ([]card, error)
+
+	// Beats reports whether hand a ranks higher than hand b under this
+	// variant's category ordering.
+	Beats(a, b hand) bool
+}
+
+// BestHandVariant evaluates each player's hole cards against the supplied
+// board under the given variant and returns the original indices of the
+// winning hand(s). board is nil for variants with no community cards.
+func BestHandVariant(v Variant, hands [][]string, board []string) ([]int, error) {
+	community, err := parseCards(board)
+	if err != nil {
+		return nil, err
+	}
+	if len(hands) == 1 { // single valid hand wins.
+		hole, err := parseCards(hands[0])
+		if err != nil {
+			return nil, err
+		}
+		if _, err := v.Eval(hole, community); err != nil {
+			return nil, err
+		}
+		return []int{0}, nil
+	}
+	scored := make([]hand, len(hands))
+	for i, holeStrs := range hands {
+		hole, err := parseCards(holeStrs)
+		if err != nil {
+			return nil, err
+		}
+		h, err := v.Eval(hole, community)
+		if err != nil {
+			return nil, err
+		}
+		h.originalIndex = i
+		scored[i] = h
+	}
+	best := scored[0]
+	for _, h := range scored[1:] {
+		if v.Beats(h, best) {
+			best = h
+		}
+	}
+	indices := []int{}
+	for _, h := range scored {
+		if !v.Beats(best, h) && !v.Beats(h, best) {
+			indices = append(indices, h.originalIndex)
+		}
+	}
+	return indices, nil
+}
+
+// parseCards parses each token (e.g. "A♤") into a card.
+func parseCards(tokens []string) ([]card, error) {
+	cards := make([]card, 0, len(tokens))
+	for _, t := range tokens {
+		c, err := parseCard(t)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
+
+// studVariant is the default 5-card stud-style variant with no community
+// cards. BestHand is a thin wrapper over it.
+type studVariant struct{}
+
+func (studVariant) Deal(players int, rnd *rand.Rand) ([][]card, []card, error) {
+	holes, _, err := dealHoleCards(newStandardDeck(), 5, 0, players, rnd)
+	return holes, nil, err
+}
+
+func (studVariant) Eval(holeCards, _ []card) (hand, error) {
+	if len(holeCards) != 5 {
+		return hand{}, errors.New("invalid hand length")
+	}
+	return categoriseHand(holeCards), nil
+}
+
+func (studVariant) BestFive(holeCards, _ []card) ([]card, error) {
+	if len(holeCards) != 5 {
+		return nil, errors.New("invalid hand length")
+	}
+	return holeCards, nil
+}
+
+func (studVariant) Beats(a, b hand) bool { return betterHand(a, b) }
+
+// HoldemVariant implements Texas Hold'em: each player receives two hole
+// cards and may use any combination of their hole cards and the five
+// community cards to make their best five-card hand.
+type HoldemVariant struct{}
+
+func (HoldemVariant) Deal(players int, rnd *rand.Rand) ([][]card, []card, error) {
+	return dealHoleCards(newStandardDeck(), 2, 5, players, rnd)
+}
+
+func (v HoldemVariant) Eval(holeCards, community []card) (hand, error) {
+	cards, err := v.BestFive(holeCards, community)
+	if err != nil {
+		return hand{}, err
+	}
+	return categoriseHand(cards), nil
+}
+
+func (HoldemVariant) BestFive(holeCards, community []card) ([]card, error) {
+	all := append(append([]card{}, holeCards...), community...)
+	if len(all) < 5 {
+		return nil, errors.New("not enough cards to make a hand")
+	}
+	return bestFiveOf(all, categoriseHand, betterHand), nil
+}
+
+func (HoldemVariant) Beats(a, b hand) bool { return betterHand(a, b) }
+
+// OmahaVariant implements Omaha: each player receives four hole cards and
+// must use exactly two of them together with exactly three of the five
+// community cards.
+type OmahaVariant struct{}
+
+func (OmahaVariant) Deal(players int, rnd *rand.Rand) ([][]card, []card, error) {
+	return dealHoleCards(newStandardDeck(), 4, 5, players, rnd)
+}
+
+func (v OmahaVariant) Eval(holeCards, community []card) (hand, error) {
+	cards, err := v.BestFive(holeCards, community)
+	if err != nil {
+		return hand{}, err
+	}
+	return categoriseHand(cards), nil
+}
+
+func (OmahaVariant) BestFive(holeCards, community []card) ([]card, error) {
+	if len(holeCards) != 4 {
+		return nil, fmt.Errorf("omaha requires exactly 4 hole cards, got %d", len(holeCards))
+	}
+	if len(community) != 5 {
+		return nil, fmt.Errorf("omaha requires exactly 5 community cards, got %d", len(community))
+	}
+	var best []card
+	var bestHand hand
+	first := true
+	combinations(4, 2, func(hi []int) {
+		combinations(5, 3, func(ci []int) {
+			candidate := make([]card, 0, 5)
+			for _, i := range hi {
+				candidate = append(candidate, holeCards[i])
+			}
+			for _, i := range ci {
+				candidate = append(candidate, community[i])
+			}
+			h := categoriseHand(candidate)
+			if first || betterHand(h, bestHand) {
+				first = false
+				bestHand = h
+				best = candidate
+			}
+		})
+	})
+	return best, nil
+}
+
+func (OmahaVariant) Beats(a, b hand) bool { return betterHand(a, b) }
+
+// ShortDeckVariant implements Short Deck (6+) hold'em: ranks 2-5 are
+// removed from the deck, A-6-7-8-9 is the lowest straight in place of
+// A-2-3-4-5, and flush outranks full house since removing the low cards
+// makes flushes harder to make.
+type ShortDeckVariant struct{}
+
+func (ShortDeckVariant) Deal(players int, rnd *rand.Rand) ([][]card, []card, error) {
+	return dealHoleCards(newShortDeck(), 2, 5, players, rnd)
+}
+
+func (v ShortDeckVariant) Eval(holeCards, community []card) (hand, error) {
+	cards, err := v.BestFive(holeCards, community)
+	if err != nil {
+		return hand{}, err
+	}
+	return categoriseHandShortDeck(cards), nil
+}
+
+func (ShortDeckVariant) BestFive(holeCards, community []card) ([]card, error) {
+	all := append(append([]card{}, holeCards...), community...)
+	if len(all) < 5 {
+		return nil, errors.New("not enough cards to make a hand")
+	}
+	return bestFiveOf(all, categoriseHandShortDeck, betterHandShortDeck), nil
+}
+
+func (ShortDeckVariant) Beats(a, b hand) bool { return betterHandShortDeck(a, b) }
+
+// bestFiveOf returns the five cards out of the candidates that make the
+// strongest hand under the supplied categorisation and ordering functions.
+func bestFiveOf(cards []card, categorise func([]card) hand, beats func(a, b hand) bool) []card {
+	var best []card
+	var bestHand hand
+	first := true
+	combinations(len(cards), 5, func(idx []int) {
+		candidate := make([]card, len(idx))
+		for i, ci := range idx {
+			candidate[i] = cards[ci]
+		}
+		h := categorise(candidate)
+		if first || beats(h, bestHand) {
+			first = false
+			bestHand = h
+			best = candidate
+		}
+	})
+	return best
+}
+
+// combinations invokes fn with every k-length subset of indices [0,n), in
+// ascending order.
+func combinations(n, k int, fn func(idx []int)) {
+	idx := make([]int, k)
+	var rec func(start, depth int)
+	rec = func(start, depth int) {
+		if depth == k {
+			fn(append([]int{}, idx...))
+			return
+		}
+		for i := start; i <= n-(k-depth); i++ {
+			idx[depth] = i
+			rec(i+1, depth+1)
+		}
+	}
+	rec(0, 0)
+}
+
+// newStandardDeck builds an unshuffled 52-card deck using the ranks and
+// suits recognised by validateHand.
+func newStandardDeck() []card {
+	return buildDeck(2, 14)
+}
+
+// newShortDeck builds the 36-card deck used by Short Deck (6+) hold'em,
+// where ranks below 6 are removed.
+func newShortDeck() []card {
+	return buildDeck(6, 14)
+}
+
+func buildDeck(lowRank, highRank int) []card {
+	suits := []string{"♢", "♧", "♡", "♤"}
+	deck := make([]card, 0, (highRank-lowRank+1)*len(suits))
+	for r := lowRank; r <= highRank; r++ {
+		for _, s := range suits {
+			deck = append(deck, card{rank: r, suit: s})
+		}
+	}
+	return deck
+}
+
+// dealHoleCards shuffles cards and deals holeCount hole cards to each of
+// the given players, reserving communityCount cards for the board.
+func dealHoleCards(cards []card, holeCount, communityCount, players int, rnd *rand.Rand) ([][]card, []card, error) {
+	if players <= 0 {
+		return nil, nil, errors.New("players must be positive")
+	}
+	d := newDeckFromCards(cards)
+	d.Shuffle(rnd)
+	holes := make([][]card, players)
+	for p := 0; p < players; p++ {
+		hole, err := d.Draw(holeCount)
+		if err != nil {
+			return nil, nil, fmt.Errorf("not enough cards in deck for %d players", players)
+		}
+		holes[p] = hole
+	}
+	community, err := d.Draw(communityCount)
+	if err != nil {
+		return nil, nil, fmt.Errorf("not enough cards in deck for %d players", players)
+	}
+	return holes, community, nil
+}
+
+// categoriseHandShortDeck scores a 5-card hand under Short Deck (6+)
+// rules, delegating to RankShortDeck/RankShortDeckCategory the same way
+// categoriseHand delegates to Rank/RankCategory.
+func categoriseHandShortDeck(cards []card) hand {
+	r := RankShortDeck(cards...)
+	return hand{cat: RankShortDeckCategory(r), rankValue: r}
+}
+
+// betterHand reports whether a ranks strictly higher than b, comparing by
+// Rank's total order directly rather than by category and tie-break fields.
+func betterHand(a, b hand) bool {
+	return a.rankValue < b.rankValue
+}
+
+// betterHandShortDeck reports whether a ranks strictly higher than b
+// under Short Deck (6+) rules, comparing by RankShortDeck's total order,
+// which already has flush outranking full house built in.
+func betterHandShortDeck(a, b hand) bool {
+	return a.rankValue < b.rankValue
+}
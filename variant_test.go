@@ -0,0 +1,130 @@
+package poker
+
+import "testing"
+
+func TestBestHandVariantHoldemTripBeatsPair(t *testing.T) {
+	board := []string{"2♢", "7♧", "9♡", "J♢", "A♤"}
+	hands := [][]string{
+		{"A♧", "A♡"}, // trip aces, using the board's ace
+		{"Q♧", "Q♡"}, // pair of queens only
+	}
+	got, err := BestHandVariant(HoldemVariant{}, hands, board)
+	if err != nil {
+		t.Fatalf("BestHandVariant: %v", err)
+	}
+	if len(got) != 1 || got[0] != 0 {
+		t.Errorf("winners = %v, want [0] (trip aces beats pair of queens)", got)
+	}
+}
+
+func TestBestHandVariantHoldemTie(t *testing.T) {
+	board := []string{"2♢", "7♧", "9♡", "J♢", "A♤"}
+	hands := [][]string{
+		{"K♧", "3♡"}, // both just play the board, ace high with a king kicker
+		{"K♢", "4♤"},
+	}
+	got, err := BestHandVariant(HoldemVariant{}, hands, board)
+	if err != nil {
+		t.Fatalf("BestHandVariant: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("winners = %v, want both hands to tie on the board plus a king kicker", got)
+	}
+}
+
+func TestBestHandVariantOmahaStraightBeatsHighCard(t *testing.T) {
+	board := []string{"2♢", "3♧", "4♡", "5♢", "6♧"}
+	hands := [][]string{
+		{"7♧", "8♡", "2♤", "2♡"}, // 4-5-6-7-8 straight, using exactly 2 hole + 3 board
+		{"K♧", "Q♡", "J♤", "9♡"}, // no improvement on the board: high card K-Q-6-5-4
+	}
+	got, err := BestHandVariant(OmahaVariant{}, hands, board)
+	if err != nil {
+		t.Fatalf("BestHandVariant: %v", err)
+	}
+	if len(got) != 1 || got[0] != 0 {
+		t.Errorf("winners = %v, want [0] (straight beats high card)", got)
+	}
+}
+
+func TestBestHandVariantOmahaExactlyTwoHoleCards(t *testing.T) {
+	// Three of the player's four hole cards are nines, but Omaha only
+	// allows exactly two of them to be used, so the board's own nine can't
+	// be combined with all three to make quads.
+	hole := []card{
+		mustParseCard(t, "9♧"), mustParseCard(t, "9♡"), mustParseCard(t, "9♢"), mustParseCard(t, "2♧"),
+	}
+	board := []card{
+		mustParseCard(t, "9♤"), mustParseCard(t, "K♢"), mustParseCard(t, "Q♢"), mustParseCard(t, "J♢"), mustParseCard(t, "3♢"),
+	}
+	h, err := OmahaVariant{}.Eval(hole, board)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if h.cat != threeKind {
+		t.Errorf("category = %v, want threeKind (quads are impossible under the exactly-two-hole-cards rule)", h.cat)
+	}
+}
+
+func TestBestHandVariantShortDeckFlushBeatsFullHouse(t *testing.T) {
+	board := []string{"7♤", "8♤", "10♤", "6♢", "6♧"}
+	hands := [][]string{
+		{"6♤", "Q♤"}, // flush in spades: 6-7-8-10-Q
+		{"6♡", "7♡"}, // full house: three sixes, pair of sevens
+	}
+	got, err := BestHandVariant(ShortDeckVariant{}, hands, board)
+	if err != nil {
+		t.Fatalf("BestHandVariant: %v", err)
+	}
+	if len(got) != 1 || got[0] != 0 {
+		t.Errorf("winners = %v, want [0] (flush beats full house under short deck rules)", got)
+	}
+}
+
+func TestBestHandVariantShortDeckWheelStraight(t *testing.T) {
+	cards := []card{
+		mustParseCard(t, "A♤"), mustParseCard(t, "6♧"), mustParseCard(t, "7♡"), mustParseCard(t, "8♢"), mustParseCard(t, "9♤"),
+	}
+	h, err := ShortDeckVariant{}.Eval(nil, cards)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if h.cat != straight {
+		t.Errorf("category = %v, want straight for A-6-7-8-9", h.cat)
+	}
+}
+
+func TestBestHandVariantShortDeckBreaksKickerTies(t *testing.T) {
+	// Both hands are high card with the same top card (king) and the same
+	// rank sum (45), but the first hand's jack kicker should beat the
+	// second hand's ten.
+	jackKicker := []card{
+		mustParseCard(t, "K♤"), mustParseCard(t, "J♧"), mustParseCard(t, "8♡"), mustParseCard(t, "7♢"), mustParseCard(t, "6♤"),
+	}
+	tenKicker := []card{
+		mustParseCard(t, "K♧"), mustParseCard(t, "10♤"), mustParseCard(t, "9♢"), mustParseCard(t, "7♡"), mustParseCard(t, "6♧"),
+	}
+	higher, err := ShortDeckVariant{}.Eval(nil, jackKicker)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	lower, err := ShortDeckVariant{}.Eval(nil, tenKicker)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if !betterHandShortDeck(higher, lower) {
+		t.Errorf("K-J-8-7-6 should beat K-10-9-7-6 on the jack kicker, got a tie or loss")
+	}
+	if betterHandShortDeck(lower, higher) {
+		t.Errorf("K-10-9-7-6 should not beat K-J-8-7-6")
+	}
+}
+
+func mustParseCard(t *testing.T, token string) card {
+	t.Helper()
+	c, err := parseCard(token)
+	if err != nil {
+		t.Fatalf("parseCard(%q): %v", token, err)
+	}
+	return c
+}